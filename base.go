@@ -0,0 +1,198 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// LogLevel indicates the severity at which an error produced from a Base
+// should be logged, independent of the HTTP/gRPC status it maps to.
+type LogLevel int
+
+const (
+	// LogLevelDebug marks an error as routine, useful only for debugging.
+	LogLevelDebug LogLevel = iota
+
+	// LogLevelInfo marks an error as expected, noteworthy application behavior.
+	LogLevelInfo
+
+	// LogLevelWarn marks an error as unexpected but non-critical.
+	LogLevelWarn
+
+	// LogLevelError marks an error as requiring operator attention. This is
+	// the default level for a Base created without WithLogLevel.
+	LogLevelError
+)
+
+// String returns the lowercase name of the LogLevel.
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelDebug:
+		return "debug"
+	case LogLevelInfo:
+		return "info"
+	case LogLevelWarn:
+		return "warn"
+	default:
+		return "error"
+	}
+}
+
+// Base is a static, per-call-site definition of an error: a stable MessageID,
+// the Slug it maps to, a PublicMessage safe to return to end users, and
+// metadata describing how it should be logged and whether it is retryable.
+// A Base is created once (typically as a package-level var) and produces
+// dynamic, per-invocation Err values via Errorf and Wrap.
+type Base struct {
+	messageID     string
+	reason        Slug
+	publicMessage string
+	logLevel      LogLevel
+	retryable     bool
+}
+
+// BaseOption configures optional fields of a Base at construction time.
+type BaseOption func(*Base)
+
+// WithPublicMessage sets the message returned to end users via ProblemJSON,
+// instead of the underlying, potentially sensitive, wrapped error text.
+func WithPublicMessage(message string) BaseOption {
+	return func(b *Base) {
+		b.publicMessage = message
+	}
+}
+
+// WithLogLevel sets the severity at which errors from this Base should be
+// logged. Defaults to LogLevelError.
+func WithLogLevel(level LogLevel) BaseOption {
+	return func(b *Base) {
+		b.logLevel = level
+	}
+}
+
+// WithRetryable marks errors from this Base as safe (or unsafe) for callers
+// to retry.
+func WithRetryable(retryable bool) BaseOption {
+	return func(b *Base) {
+		b.retryable = retryable
+	}
+}
+
+// NewBase registers and returns a new Base error definition. messageID is a
+// stable, dotted identifier (e.g. "users.not-found") used to track this error
+// definition across the process-wide Registry. It panics if messageID has
+// already been registered, since message IDs are expected to be unique.
+func NewBase(messageID string, reason Slug, opts ...BaseOption) Base {
+	b := Base{
+		messageID: messageID,
+		reason:    reason,
+		logLevel:  LogLevelError,
+	}
+
+	for _, opt := range opts {
+		opt(&b)
+	}
+
+	register(b)
+
+	return b
+}
+
+// MessageID returns the stable, dotted identifier of the Base.
+func (b Base) MessageID() string {
+	return b.messageID
+}
+
+// Reason returns the Slug this Base maps to.
+func (b Base) Reason() Slug {
+	return b.reason
+}
+
+// PublicMessage returns the message safe to return to end users.
+func (b Base) PublicMessage() string {
+	return b.publicMessage
+}
+
+// LogLevel returns the severity at which errors from this Base should be logged.
+func (b Base) LogLevel() LogLevel {
+	return b.logLevel
+}
+
+// Retryable reports whether errors from this Base are safe for callers to retry.
+func (b Base) Retryable() bool {
+	return b.retryable
+}
+
+// Errorf creates an Err bound to this Base, formatting format and args into
+// the underlying error message the same way fmt.Errorf would.
+func (b Base) Errorf(format string, args ...any) Err {
+	base := b
+	return Err{
+		slug:  b.reason,
+		error: fmt.Errorf(format, args...),
+		base:  &base,
+	}
+}
+
+// Wrap creates an Err bound to this Base around an existing error, preserving
+// it as the underlying cause. Any args are joined into the Err's details.
+func (b Base) Wrap(err error, args ...any) Err {
+	base := b
+	e := Err{
+		slug:  b.reason,
+		error: err,
+		base:  &base,
+	}
+
+	if len(args) > 0 {
+		e.details = fmt.Sprint(args...)
+	}
+
+	return e
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = map[string]Base{}
+)
+
+// register records b in the process-wide Registry, panicking if its
+// MessageID has already been registered by another Base.
+func register(b Base) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, exists := registry[b.messageID]; exists {
+		panic(fmt.Sprintf("errs: message id %q is already registered", b.messageID))
+	}
+
+	registry[b.messageID] = b
+}
+
+// Registry returns a snapshot of every Base registered via NewBase, keyed by
+// MessageID. It is primarily useful for generating documentation, populating
+// dashboards, or asserting that message IDs are unique across a codebase.
+func Registry() map[string]Base {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	out := make(map[string]Base, len(registry))
+	for k, v := range registry {
+		out[k] = v
+	}
+
+	return out
+}
+
+// BaseFromErr extracts the Base bound to a given error, if the error is of
+// type Err and was created from a Base via Errorf or Wrap. The second return
+// value is false if the error carries no Base.
+func BaseFromErr(err error) (Base, bool) {
+	var e Err
+	if errors.As(err, &e) && e.base != nil {
+		return *e.base, true
+	}
+
+	return Base{}, false
+}
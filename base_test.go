@@ -0,0 +1,89 @@
+package errs_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/valensto/errs"
+)
+
+func TestNewBaseAndRegistry(t *testing.T) {
+	base := errs.NewBase("test.new-base-and-registry", errs.SlugNotFound,
+		errs.WithPublicMessage("resource not found"),
+		errs.WithLogLevel(errs.LogLevelWarn),
+		errs.WithRetryable(true),
+	)
+
+	if got := base.MessageID(); got != "test.new-base-and-registry" {
+		t.Errorf("MessageID() = %q, want %q", got, "test.new-base-and-registry")
+	}
+	if got := base.Reason(); got.Error() != errs.SlugNotFound.Error() {
+		t.Errorf("Reason() = %v, want %v", got, errs.SlugNotFound)
+	}
+	if got := base.PublicMessage(); got != "resource not found" {
+		t.Errorf("PublicMessage() = %q, want %q", got, "resource not found")
+	}
+	if got := base.LogLevel(); got != errs.LogLevelWarn {
+		t.Errorf("LogLevel() = %v, want %v", got, errs.LogLevelWarn)
+	}
+	if !base.Retryable() {
+		t.Error("Retryable() = false, want true")
+	}
+
+	registered, ok := errs.Registry()["test.new-base-and-registry"]
+	if !ok {
+		t.Fatal("Registry() missing registered base")
+	}
+	if registered.MessageID() != base.MessageID() {
+		t.Errorf("Registry()[...].MessageID() = %q, want %q", registered.MessageID(), base.MessageID())
+	}
+}
+
+func TestNewBaseDuplicateMessageIDPanics(t *testing.T) {
+	errs.NewBase("test.duplicate-message-id", errs.SlugInternal)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("NewBase() with duplicate messageID did not panic")
+		}
+	}()
+
+	errs.NewBase("test.duplicate-message-id", errs.SlugInternal)
+}
+
+func TestBaseErrorfAndWrap(t *testing.T) {
+	base := errs.NewBase("test.errorf-and-wrap", errs.SlugInvalid)
+
+	err := base.Errorf("invalid value: %d", 42)
+	got, ok := errs.BaseFromErr(err)
+	if !ok {
+		t.Fatal("BaseFromErr() ok = false, want true")
+	}
+	if got.MessageID() != base.MessageID() {
+		t.Errorf("BaseFromErr().MessageID() = %q, want %q", got.MessageID(), base.MessageID())
+	}
+
+	wrapped := base.Wrap(errors.New("boom"), "while processing")
+	if _, ok := errs.BaseFromErr(wrapped); !ok {
+		t.Error("BaseFromErr() on wrapped error ok = false, want true")
+	}
+}
+
+func TestBaseFromErrWithoutBase(t *testing.T) {
+	if _, ok := errs.BaseFromErr(errs.New(errs.SlugInternal)); ok {
+		t.Error("BaseFromErr() ok = true, want false for an Err with no Base")
+	}
+}
+
+func TestProblemJSONUsesBasePublicMessage(t *testing.T) {
+	base := errs.NewBase("test.public-message-override", errs.SlugInternal,
+		errs.WithPublicMessage("something went wrong"),
+	)
+	err := base.Wrap(errors.New("db connection refused: 10.0.0.1:5432"))
+
+	got := errs.ProblemJSON(err, "http://example.com/err/1234")
+
+	if got["detail"] != "something went wrong" {
+		t.Errorf(`ProblemJSON()["detail"] = %v, want "something went wrong"`, got["detail"])
+	}
+}
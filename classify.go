@@ -0,0 +1,48 @@
+package errs
+
+// IsRetryable reports whether err represents a condition that is safe for a
+// caller to retry. Errors bound to a Base report the Base's Retryable
+// setting, which is false unless WithRetryable(true) was given, whatever the
+// Base's slug; all other errors default to IsTransient.
+func IsRetryable(err error) bool {
+	if base, ok := BaseFromErr(err); ok {
+		return base.Retryable()
+	}
+
+	return IsTransient(err)
+}
+
+// IsTransient reports whether err's slug conventionally represents a
+// transient, upstream condition (Unavailable, Timeout, TooManyRequests) that
+// is expected to resolve on its own rather than a problem with the request
+// itself.
+func IsTransient(err error) bool {
+	switch SlugFromErr(err).(type) {
+	case Unavailable, Timeout, TooManyRequests:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsClientError reports whether err maps to an HTTP 4xx status code.
+func IsClientError(err error) bool {
+	status := HTTPStatus(err)
+	return status >= 400 && status < 500
+}
+
+// IsServerError reports whether err maps to an HTTP 5xx status code.
+func IsServerError(err error) bool {
+	return HTTPStatus(err) >= 500
+}
+
+// IsAuth reports whether err's slug represents an authentication or
+// authorization failure (Unauthorized or Forbidden).
+func IsAuth(err error) bool {
+	switch SlugFromErr(err).(type) {
+	case Unauthorized, Forbidden:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,92 @@
+package errs_test
+
+import (
+	"testing"
+
+	"github.com/valensto/errs"
+)
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "unavailable", err: errs.New(errs.SlugUnavailable), want: true},
+		{name: "timeout", err: errs.New(errs.SlugTimeout), want: true},
+		{name: "too many requests", err: errs.New(errs.SlugTooManyRequests), want: true},
+		{name: "not found", err: errs.New(errs.SlugNotFound), want: false},
+		{name: "internal", err: errs.New(errs.SlugInternal), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errs.IsTransient(tt.err); got != tt.want {
+				t.Errorf("IsTransient() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	base := errs.NewBase("test.is-retryable-override", errs.SlugInvalid, errs.WithRetryable(true))
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "transient slug, no base", err: errs.New(errs.SlugTimeout), want: true},
+		{name: "non-transient slug, no base", err: errs.New(errs.SlugNotFound), want: false},
+		{name: "base overrides non-retryable slug", err: base.Errorf("invalid"), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errs.IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsClientAndServerError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantClient bool
+		wantServer bool
+	}{
+		{name: "not found", err: errs.New(errs.SlugNotFound), wantClient: true, wantServer: false},
+		{name: "too many requests", err: errs.New(errs.SlugTooManyRequests), wantClient: true, wantServer: false},
+		{name: "internal", err: errs.New(errs.SlugInternal), wantClient: false, wantServer: true},
+		{name: "unavailable", err: errs.New(errs.SlugUnavailable), wantClient: false, wantServer: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errs.IsClientError(tt.err); got != tt.wantClient {
+				t.Errorf("IsClientError() = %v, want %v", got, tt.wantClient)
+			}
+			if got := errs.IsServerError(tt.err); got != tt.wantServer {
+				t.Errorf("IsServerError() = %v, want %v", got, tt.wantServer)
+			}
+		})
+	}
+}
+
+func TestIsAuth(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "unauthorized", err: errs.New(errs.SlugUnauthorized), want: true},
+		{name: "forbidden", err: errs.New(errs.SlugForbidden), want: true},
+		{name: "not found", err: errs.New(errs.SlugNotFound), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errs.IsAuth(tt.err); got != tt.want {
+				t.Errorf("IsAuth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
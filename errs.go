@@ -11,6 +11,7 @@ import (
 	"fmt"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	"strconv"
 	"strings"
 )
 
@@ -53,6 +54,8 @@ type Err struct {
 	error   error
 	details string
 	params  Params
+	errList ErrorList
+	base    *Base
 }
 
 // Error returns a string representation of the Err. It combines the underlying
@@ -89,7 +92,10 @@ func NewFromError(err error) Err {
 // by the validator package. It translates validation error messages using the
 // provided translator and associates them with their corresponding field names
 // in the Params map. This function is particularly useful for handling validation
-// errors in a structured and user-friendly manner.
+// errors in a structured and user-friendly manner. The translator is expected to
+// already be locale-aware (e.g. built from the request's Accept-Language), so the
+// resulting field messages stay consistent with whatever locale RegisterLocale
+// and Err.Localize use for the rest of the response.
 func NewFromValidator(err error, translator ut.Translator) Err {
 	var invalidValidationError *validator.InvalidValidationError
 	if errors.As(err, &invalidValidationError) {
@@ -111,11 +117,67 @@ func NewFromValidator(err error, translator ut.Translator) Err {
 
 		field := strings.ToLower(err.Field())
 		e.params.Add(field, msg)
+		e.errList = append(e.errList, fieldErrorFromValidator(err, msg))
 	}
 
 	return e
 }
 
+// fieldErrorFromValidator maps a validator.FieldError to the FieldError
+// constructor matching its validation tag, so the resulting ErrorKind
+// reflects the kind of failure (missing, too long, unsupported value, ...)
+// rather than always reporting a generic invalid value.
+func fieldErrorFromValidator(err validator.FieldError, msg string) *FieldError {
+	path := pathFromNamespace(err.Namespace())
+
+	switch err.Tag() {
+	case "required":
+		return NewRequired(path, msg)
+	case "max", "len", "lte":
+		maxLength, _ := strconv.Atoi(err.Param())
+		return NewTooLong(path, err.Value(), maxLength)
+	case "oneof":
+		return NewNotSupported(path, err.Value(), strings.Fields(err.Param()))
+	case "unique":
+		return NewDuplicate(path, err.Value())
+	default:
+		return NewInvalid(path, err.Value(), msg)
+	}
+}
+
+// pathFromNamespace builds a Path from a validator.v10 namespace such as
+// "User.Address.Street[0]", preserving the full struct path rather than just
+// the leaf field name.
+func pathFromNamespace(namespace string) *Path {
+	segments := strings.Split(namespace, ".")
+
+	var path *Path
+	for _, segment := range segments {
+		name := segment
+		var index string
+		if i := strings.Index(segment, "["); i >= 0 && strings.HasSuffix(segment, "]") {
+			name = segment[:i]
+			index = segment[i+1 : len(segment)-1]
+		}
+
+		if path == nil {
+			path = NewPath(name)
+		} else {
+			path = path.Child(name)
+		}
+
+		if index != "" {
+			if n, convErr := strconv.Atoi(index); convErr == nil {
+				path = path.Index(n)
+			} else {
+				path = path.Key(index)
+			}
+		}
+	}
+
+	return path
+}
+
 // WithError enriches the Err instance with an additional underlying error, allowing
 // for error wrapping and chaining. This method is useful for building a detailed
 // error trace.
@@ -145,6 +207,26 @@ func (e Err) WithParams(params map[string]string) Err {
 	return e
 }
 
+// WithErrorList attaches an ErrorList of structured field-level validation
+// failures to the Err, allowing callers to build FieldErrors by hand (outside
+// of NewFromValidator) and still surface them through ProblemJSON.
+func (e Err) WithErrorList(list ErrorList) Err {
+	e.errList = append(e.errList, list...)
+	return e
+}
+
+// ErrorListFromErr extracts the ErrorList from a given error, if the error is
+// of type Err and contains field-level validation failures. If the error
+// does not contain an ErrorList, nil is returned.
+func ErrorListFromErr(err error) ErrorList {
+	var e Err
+	if errors.As(err, &e) {
+		return e.errList
+	}
+
+	return nil
+}
+
 // DetailFromErr extracts the detailed error message from a given error, if the error is of
 // type Err and contains detailed information. This allows for the retrieval of additional
 // error context useful for logging or displaying to an end user. If the error does not contain
@@ -0,0 +1,87 @@
+package errs_test
+
+import (
+	"reflect"
+	"testing"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/valensto/errs"
+)
+
+// fakeFieldError implements validator.FieldError for testing NewFromValidator
+// without depending on an actual struct validation run.
+type fakeFieldError struct {
+	tag       string
+	param     string
+	field     string
+	namespace string
+	value     any
+	msg       string
+}
+
+func (f fakeFieldError) Error() string                  { return f.msg }
+func (f fakeFieldError) Tag() string                    { return f.tag }
+func (f fakeFieldError) Param() string                  { return f.param }
+func (f fakeFieldError) Field() string                  { return f.field }
+func (f fakeFieldError) Namespace() string              { return f.namespace }
+func (f fakeFieldError) Value() any                     { return f.value }
+func (f fakeFieldError) Translate(ut.Translator) string { return f.msg }
+func (f fakeFieldError) ActualTag() string              { return f.tag }
+func (f fakeFieldError) StructNamespace() string        { return f.namespace }
+func (f fakeFieldError) StructField() string            { return f.field }
+func (f fakeFieldError) Kind() reflect.Kind             { return reflect.ValueOf(f.value).Kind() }
+func (f fakeFieldError) Type() reflect.Type             { return reflect.TypeOf(f.value) }
+
+func TestNewFromValidator(t *testing.T) {
+	tests := []struct {
+		name     string
+		fe       fakeFieldError
+		wantKind errs.ErrorKind
+	}{
+		{
+			name:     "required",
+			fe:       fakeFieldError{tag: "required", field: "Name", namespace: "User.Name", msg: "Name is required"},
+			wantKind: errs.ErrorKindRequired,
+		},
+		{
+			name:     "max",
+			fe:       fakeFieldError{tag: "max", param: "10", field: "Bio", namespace: "User.Bio", value: "way too long a bio", msg: "Bio is too long"},
+			wantKind: errs.ErrorKindTooLong,
+		},
+		{
+			name:     "oneof",
+			fe:       fakeFieldError{tag: "oneof", param: "admin user", field: "Role", namespace: "User.Role", value: "guest", msg: "Role must be one of admin user"},
+			wantKind: errs.ErrorKindNotSupported,
+		},
+		{
+			name:     "unique",
+			fe:       fakeFieldError{tag: "unique", field: "Email", namespace: "User.Email", value: "dup@example.com", msg: "Email must be unique"},
+			wantKind: errs.ErrorKindDuplicate,
+		},
+		{
+			name:     "email falls back to invalid",
+			fe:       fakeFieldError{tag: "email", field: "Email", namespace: "User.Email", value: "not-an-email", msg: "Email must be a valid email"},
+			wantKind: errs.ErrorKindInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valErr := validator.ValidationErrors{tt.fe}
+
+			got := errs.NewFromValidator(valErr, nil)
+
+			fieldErrors := errs.ErrorListFromErr(got)
+			if len(fieldErrors) != 1 {
+				t.Fatalf("len(ErrorListFromErr()) = %d, want 1", len(fieldErrors))
+			}
+			if fieldErrors[0].Kind != tt.wantKind {
+				t.Errorf("Kind = %v, want %v", fieldErrors[0].Kind, tt.wantKind)
+			}
+			if fieldErrors[0].Path.String() != tt.fe.namespace {
+				t.Errorf("Path.String() = %q, want %q", fieldErrors[0].Path.String(), tt.fe.namespace)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package errs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrorKind identifies the category of a FieldError, mirroring the kinds of
+// validation failures commonly found on a single struct field or element.
+type ErrorKind string
+
+const (
+	// ErrorKindRequired indicates a required value is missing.
+	ErrorKindRequired ErrorKind = "FieldValueRequired"
+
+	// ErrorKindInvalid indicates a value does not meet the field's constraints.
+	ErrorKindInvalid ErrorKind = "FieldValueInvalid"
+
+	// ErrorKindDuplicate indicates a value conflicts with another, earlier one.
+	ErrorKindDuplicate ErrorKind = "FieldValueDuplicate"
+
+	// ErrorKindNotSupported indicates a value is not one of the accepted values.
+	ErrorKindNotSupported ErrorKind = "FieldValueNotSupported"
+
+	// ErrorKindTooLong indicates a value exceeds an accepted length or size.
+	ErrorKindTooLong ErrorKind = "FieldValueTooLong"
+
+	// ErrorKindTypeInvalid indicates a value is of the wrong type.
+	ErrorKindTypeInvalid ErrorKind = "FieldValueTypeInvalid"
+
+	// ErrorKindForbidden indicates a value is not permitted in this context.
+	ErrorKindForbidden ErrorKind = "FieldValueForbidden"
+)
+
+// Path represents a dotted/indexed location of a field within a struct, such
+// as "spec.containers[0].image". It is built incrementally with Child, Index,
+// and Key, and rendered on demand with String.
+type Path struct {
+	name      string
+	bracketed bool
+	parent    *Path
+}
+
+// NewPath creates a new Path rooted at name, optionally appending further
+// child names in a single call, e.g. NewPath("spec", "containers").
+func NewPath(name string, moreNames ...string) *Path {
+	p := &Path{name: name}
+	for _, n := range moreNames {
+		p = p.Child(n)
+	}
+
+	return p
+}
+
+// Child returns a new Path with name appended as a child of p, optionally
+// appending further child names in a single call.
+func (p *Path) Child(name string, moreNames ...string) *Path {
+	child := &Path{name: name, parent: p}
+	for _, n := range moreNames {
+		child = child.Child(n)
+	}
+
+	return child
+}
+
+// Index returns a new Path with an integer index appended, representing
+// access into a slice or array element, e.g. "containers[0]".
+func (p *Path) Index(index int) *Path {
+	return &Path{name: strconv.Itoa(index), bracketed: true, parent: p}
+}
+
+// Key returns a new Path with a map key appended, representing access into a
+// map entry, e.g. "labels[app]".
+func (p *Path) Key(key string) *Path {
+	return &Path{name: key, bracketed: true, parent: p}
+}
+
+// String renders the Path as a dotted/indexed string. Segments produced by
+// Index or Key are rendered as a "[segment]" suffix attached to the previous
+// segment, so an index is never indistinguishable from a literal field name.
+func (p *Path) String() string {
+	if p == nil {
+		return ""
+	}
+
+	type segment struct {
+		name      string
+		bracketed bool
+	}
+
+	var segments []segment
+	for cur := p; cur != nil; cur = cur.parent {
+		segments = append([]segment{{name: cur.name, bracketed: cur.bracketed}}, segments...)
+	}
+
+	var b strings.Builder
+	for i, s := range segments {
+		switch {
+		case s.bracketed:
+			b.WriteString("[")
+			b.WriteString(s.name)
+			b.WriteString("]")
+		case i > 0:
+			b.WriteString(".")
+			b.WriteString(s.name)
+		default:
+			b.WriteString(s.name)
+		}
+	}
+
+	return b.String()
+}
+
+// FieldError represents a single structured validation failure on a field
+// identified by Path. It carries the invalid value and a human-readable
+// Detail describing why the value was rejected.
+type FieldError struct {
+	Kind     ErrorKind
+	Path     *Path
+	BadValue any
+	Detail   string
+}
+
+// Error returns a string representation of the FieldError, satisfying the
+// error interface.
+func (f *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", f.Path.String(), f.Detail)
+}
+
+// NewRequired returns a FieldError indicating that a required value is missing
+// at path.
+func NewRequired(path *Path, detail string) *FieldError {
+	return &FieldError{Kind: ErrorKindRequired, Path: path, Detail: detail}
+}
+
+// NewInvalid returns a FieldError indicating that badValue at path does not
+// satisfy the field's constraints.
+func NewInvalid(path *Path, badValue any, detail string) *FieldError {
+	return &FieldError{Kind: ErrorKindInvalid, Path: path, BadValue: badValue, Detail: detail}
+}
+
+// NewDuplicate returns a FieldError indicating that badValue at path conflicts
+// with another, already-seen value.
+func NewDuplicate(path *Path, badValue any) *FieldError {
+	return &FieldError{Kind: ErrorKindDuplicate, Path: path, BadValue: badValue, Detail: "duplicate value"}
+}
+
+// NewNotSupported returns a FieldError indicating that badValue at path is not
+// one of the values accepted for this field.
+func NewNotSupported(path *Path, badValue any, validValues []string) *FieldError {
+	return &FieldError{
+		Kind:     ErrorKindNotSupported,
+		Path:     path,
+		BadValue: badValue,
+		Detail:   fmt.Sprintf("supported values: %s", strings.Join(validValues, ", ")),
+	}
+}
+
+// NewTooLong returns a FieldError indicating that badValue at path exceeds
+// maxLength.
+func NewTooLong(path *Path, badValue any, maxLength int) *FieldError {
+	return &FieldError{
+		Kind:     ErrorKindTooLong,
+		Path:     path,
+		BadValue: badValue,
+		Detail:   fmt.Sprintf("must be no more than %d characters", maxLength),
+	}
+}
+
+// NewTypeInvalid returns a FieldError indicating that badValue at path is of
+// the wrong type.
+func NewTypeInvalid(path *Path, badValue any, detail string) *FieldError {
+	return &FieldError{Kind: ErrorKindTypeInvalid, Path: path, BadValue: badValue, Detail: detail}
+}
+
+// NewForbidden returns a FieldError indicating that the value at path is not
+// permitted in this context.
+func NewForbidden(path *Path, detail string) *FieldError {
+	return &FieldError{Kind: ErrorKindForbidden, Path: path, Detail: detail}
+}
+
+// ErrorList is a collection of FieldErrors that itself implements error,
+// allowing an aggregate of field-level failures to be returned or wrapped
+// as a single error.
+type ErrorList []*FieldError
+
+// Error returns the semicolon-joined messages of every FieldError in the list,
+// satisfying the error interface.
+func (list ErrorList) Error() string {
+	messages := make([]string, 0, len(list))
+	for _, fe := range list {
+		messages = append(messages, fe.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
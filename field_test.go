@@ -0,0 +1,56 @@
+package errs
+
+import "testing"
+
+func TestPathString(t *testing.T) {
+	tests := []struct {
+		name string
+		path *Path
+		want string
+	}{
+		{name: "nil", path: nil, want: ""},
+		{name: "single", path: NewPath("spec"), want: "spec"},
+		{name: "child", path: NewPath("spec").Child("containers"), want: "spec.containers"},
+		{name: "index", path: NewPath("spec", "containers").Index(0), want: "spec.containers[0]"},
+		{name: "index then child", path: NewPath("spec", "containers").Index(0).Child("image"), want: "spec.containers[0].image"},
+		{name: "key", path: NewPath("labels").Key("app"), want: "labels[app]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.path.String(); got != tt.want {
+				t.Errorf("Path.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPathFromNamespace(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		want      string
+	}{
+		{name: "simple field", namespace: "User.Email", want: "User.Email"},
+		{name: "nested struct", namespace: "User.Address.Street", want: "User.Address.Street"},
+		{name: "indexed slice", namespace: "Spec.Containers[0].Image", want: "Spec.Containers[0].Image"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pathFromNamespace(tt.namespace).String(); got != tt.want {
+				t.Errorf("pathFromNamespace(%q).String() = %q, want %q", tt.namespace, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorListError(t *testing.T) {
+	list := ErrorList{
+		NewRequired(NewPath("name"), "must not be empty"),
+		NewInvalid(NewPath("age"), -1, "must be positive"),
+	}
+
+	want := "name: must not be empty; age: must be positive"
+	if got := list.Error(); got != want {
+		t.Errorf("ErrorList.Error() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,212 @@
+// Package grpcerrs bridges the errs package with gRPC status codes. It lets
+// services built on errs return the same typed errors across gRPC boundaries
+// without redefining their error taxonomy for each transport, mirroring the
+// HTTP status mapping already provided by errs.HTTPStatus.
+package grpcerrs
+
+import (
+	"context"
+
+	"github.com/valensto/errs"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CodeFromSlug returns the gRPC status code associated with the given Slug.
+// It mirrors errs.HTTPStatus by matching the slug against the known error
+// types, defaulting to codes.Internal for anything unrecognized.
+func CodeFromSlug(slug errs.Slug) codes.Code {
+	switch slug.(type) {
+	case errs.NotFound:
+		return codes.NotFound
+	case errs.Invalid:
+		return codes.InvalidArgument
+	case errs.Unauthorized:
+		return codes.Unauthenticated
+	case errs.Forbidden:
+		return codes.PermissionDenied
+	case errs.Duplicate:
+		return codes.AlreadyExists
+	case errs.NotImplemented:
+		return codes.Unimplemented
+	case errs.Unavailable:
+		return codes.Unavailable
+	case errs.Timeout:
+		return codes.DeadlineExceeded
+	case errs.TooManyRequests:
+		return codes.ResourceExhausted
+	case errs.PreconditionFailed:
+		return codes.FailedPrecondition
+	case errs.Cancelled:
+		return codes.Canceled
+	case errs.DataLoss:
+		return codes.DataLoss
+	default:
+		return codes.Internal
+	}
+}
+
+// Pack converts an error into a *status.Status suitable for returning from a
+// gRPC handler. The slug's Params are attached as errdetails.BadRequest field
+// violations and the slug itself is attached as an errdetails.ErrorInfo so
+// that clients can recover the original taxonomy via Unpack.
+func Pack(err error) *status.Status {
+	if err == nil {
+		return status.New(codes.OK, "")
+	}
+
+	slug := errs.SlugFromErr(err)
+	code := CodeFromSlug(slug)
+	detail := errs.DetailFromErr(err)
+
+	st := status.New(code, detail)
+
+	withDetails, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: slug.Error(),
+	})
+	if detailErr == nil {
+		st = withDetails
+	}
+
+	if params := errs.ParamsFromErr(err); len(params) > 0 {
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(params))
+		for field, msg := range params {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       field,
+				Description: msg,
+			})
+		}
+
+		withViolations, violationErr := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+		if violationErr == nil {
+			st = withViolations
+		}
+	}
+
+	return st
+}
+
+// Unpack converts a *status.Status received from a gRPC call back into an
+// errs.Err, recovering the original Slug from the attached ErrorInfo and the
+// field violations from the attached BadRequest, if present.
+func Unpack(st *status.Status) errs.Err {
+	if st == nil {
+		return errs.New(errs.SlugUnknown)
+	}
+
+	slug := slugFromCode(st.Code())
+	params := errs.NewParams()
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if s, ok := slugFromReason(d.Reason); ok {
+				slug = s
+			}
+		case *errdetails.BadRequest:
+			for _, violation := range d.FieldViolations {
+				params.Add(violation.Field, violation.Description)
+			}
+		}
+	}
+
+	e := errs.New(slug, st.Message())
+	if !params.IsNil() {
+		e = e.WithParams(params)
+	}
+
+	return e
+}
+
+// UnaryServerInterceptor recovers any errs.Err returned by a unary handler
+// and converts it into a *status.Status via Pack, so handlers can keep
+// returning plain errs.Err values without knowing about gRPC.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, Pack(err).Err()
+		}
+
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor recovers any errs.Err returned by a streaming
+// handler and converts it into a *status.Status via Pack.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return Pack(err).Err()
+		}
+
+		return nil
+	}
+}
+
+// slugFromCode maps a gRPC status code back to the errs.Slug used when no
+// ErrorInfo detail is present to recover the original slug from.
+func slugFromCode(code codes.Code) errs.Slug {
+	switch code {
+	case codes.NotFound:
+		return errs.SlugNotFound
+	case codes.InvalidArgument:
+		return errs.SlugInvalid
+	case codes.Unauthenticated:
+		return errs.SlugUnauthorized
+	case codes.PermissionDenied:
+		return errs.SlugForbidden
+	case codes.AlreadyExists:
+		return errs.SlugDuplicate
+	case codes.Unimplemented:
+		return errs.SlugNotImplemented
+	case codes.Unavailable:
+		return errs.SlugUnavailable
+	case codes.DeadlineExceeded:
+		return errs.SlugTimeout
+	case codes.ResourceExhausted:
+		return errs.SlugTooManyRequests
+	case codes.FailedPrecondition:
+		return errs.SlugPreconditionFailed
+	case codes.Canceled:
+		return errs.SlugCancelled
+	case codes.DataLoss:
+		return errs.SlugDataLoss
+	case codes.OK:
+		return errs.SlugUnknown
+	default:
+		return errs.SlugInternal
+	}
+}
+
+// slugFromReason recovers the exact Slug constant from the reason string
+// attached to an errdetails.ErrorInfo, falling back to false when the reason
+// does not match a known slug.
+func slugFromReason(reason string) (errs.Slug, bool) {
+	known := []errs.Slug{
+		errs.SlugNotFound,
+		errs.SlugInvalid,
+		errs.SlugUnauthorized,
+		errs.SlugForbidden,
+		errs.SlugDuplicate,
+		errs.SlugNotImplemented,
+		errs.SlugInternal,
+		errs.SlugUnknown,
+		errs.SlugUnavailable,
+		errs.SlugTimeout,
+		errs.SlugTooManyRequests,
+		errs.SlugPreconditionFailed,
+		errs.SlugCancelled,
+		errs.SlugDataLoss,
+	}
+
+	for _, slug := range known {
+		if slug.Error() == reason {
+			return slug, true
+		}
+	}
+
+	return nil, false
+}
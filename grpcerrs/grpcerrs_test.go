@@ -0,0 +1,68 @@
+package grpcerrs_test
+
+import (
+	"testing"
+
+	"github.com/valensto/errs"
+	"github.com/valensto/errs/grpcerrs"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCodeFromSlug(t *testing.T) {
+	tests := []struct {
+		name string
+		slug errs.Slug
+		want codes.Code
+	}{
+		{name: "not found", slug: errs.SlugNotFound, want: codes.NotFound},
+		{name: "invalid", slug: errs.SlugInvalid, want: codes.InvalidArgument},
+		{name: "unauthorized", slug: errs.SlugUnauthorized, want: codes.Unauthenticated},
+		{name: "forbidden", slug: errs.SlugForbidden, want: codes.PermissionDenied},
+		{name: "duplicate", slug: errs.SlugDuplicate, want: codes.AlreadyExists},
+		{name: "not implemented", slug: errs.SlugNotImplemented, want: codes.Unimplemented},
+		{name: "internal", slug: errs.SlugInternal, want: codes.Internal},
+		{name: "unknown", slug: errs.SlugUnknown, want: codes.Internal},
+		{name: "unavailable", slug: errs.SlugUnavailable, want: codes.Unavailable},
+		{name: "timeout", slug: errs.SlugTimeout, want: codes.DeadlineExceeded},
+		{name: "too many requests", slug: errs.SlugTooManyRequests, want: codes.ResourceExhausted},
+		{name: "precondition failed", slug: errs.SlugPreconditionFailed, want: codes.FailedPrecondition},
+		{name: "cancelled", slug: errs.SlugCancelled, want: codes.Canceled},
+		{name: "data loss", slug: errs.SlugDataLoss, want: codes.DataLoss},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := grpcerrs.CodeFromSlug(tt.slug); got != tt.want {
+				t.Errorf("CodeFromSlug() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPackUnpackRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		err  errs.Err
+		want errs.Slug
+	}{
+		{name: "not found", err: errs.New(errs.SlugNotFound, "user 42"), want: errs.SlugNotFound},
+		{name: "invalid", err: errs.New(errs.SlugInvalid).WithParams(map[string]string{"email": "required"}), want: errs.SlugInvalid},
+		{name: "duplicate", err: errs.New(errs.SlugDuplicate), want: errs.SlugDuplicate},
+		{name: "unavailable", err: errs.New(errs.SlugUnavailable), want: errs.SlugUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := grpcerrs.Pack(tt.err)
+			unpacked := grpcerrs.Unpack(st)
+
+			if got := errs.SlugFromErr(unpacked); got.Error() != tt.want.Error() {
+				t.Errorf("SlugFromErr(Unpack(Pack(err))) = %v, want %v", got, tt.want)
+			}
+
+			for k, v := range errs.ParamsFromErr(tt.err) {
+				if errs.ParamsFromErr(unpacked)[k] != v {
+					t.Errorf("param %q = %v, want %v", k, errs.ParamsFromErr(unpacked)[k], v)
+				}
+			}
+		})
+	}
+}
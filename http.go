@@ -1,7 +1,6 @@
 package errs
 
 import (
-	"errors"
 	"net/http"
 )
 
@@ -16,6 +15,11 @@ const (
 	BlankType ProblemJSONType = "about:blank"
 )
 
+// statusClientClosedRequest is the conventional, non-standard HTTP status
+// code used to report that a client cancelled the request before the server
+// could complete it.
+const statusClientClosedRequest = 499
+
 // HTTPStatus takes an error object as input and returns the corresponding
 // HTTP status code. It works by unwrapping the provided error to its base type
 // and then matching it against a set of predefined errors. Each predefined error
@@ -36,24 +40,38 @@ const (
 // appropriate HTTP responses, thereby encapsulating the error handling logic
 // and promoting a cleaner and more maintainable codebase.
 func HTTPStatus(err error) int {
-	e := getErr(err)
-
-	errorMap := map[error]int{
-		(*NotFound)(nil):       http.StatusNotFound,
-		(*BadRequest)(nil):     http.StatusBadRequest,
-		(*Unauthorized)(nil):   http.StatusUnauthorized,
-		(*Forbidden)(nil):      http.StatusForbidden,
-		(*Duplicate)(nil):      http.StatusConflict,
-		(*NotImplemented)(nil): http.StatusNotImplemented,
+	if err == nil {
+		return http.StatusOK
 	}
 
-	for errType, statusCode := range errorMap {
-		if errors.As(e.slug, &errType) {
-			return statusCode
-		}
-	}
+	e := getErr(err)
 
-	return http.StatusInternalServerError
+	switch e.slug.(type) {
+	case NotFound:
+		return http.StatusNotFound
+	case Invalid:
+		return http.StatusBadRequest
+	case Unauthorized:
+		return http.StatusUnauthorized
+	case Forbidden:
+		return http.StatusForbidden
+	case Duplicate:
+		return http.StatusConflict
+	case NotImplemented:
+		return http.StatusNotImplemented
+	case Unavailable:
+		return http.StatusServiceUnavailable
+	case Timeout:
+		return http.StatusGatewayTimeout
+	case TooManyRequests:
+		return http.StatusTooManyRequests
+	case PreconditionFailed:
+		return http.StatusPreconditionFailed
+	case Cancelled:
+		return statusClientClosedRequest
+	default:
+		return http.StatusInternalServerError
+	}
 }
 
 // ProblemJSON constructs a map representing a Problem Details object as specified by RFC 9457.
@@ -66,9 +84,14 @@ func HTTPStatus(err error) int {
 // - type: A URI reference (ProblemJSONType) that identifies the problem type.
 // - title: A short, human-readable summary of the problem type represented by the slug extracted from the error.
 // - status: The HTTP status code generated from the error.
-// - detail: A human-readable explanation specific to this occurrence of the problem.
+// - detail: A human-readable explanation specific to this occurrence of the problem. When the
+// error is bound to a Base with a PublicMessage set, that message is used instead of the Err's
+// own details, so the underlying wrapped error text is never leaked to clients.
 // - instance: A URI reference that identifies the specific occurrence of the problem.
 // Additional fields, like 'params', provide further details about the problem when available.
+// - errors: An RFC 9457 extension listing structured field-level validation failures, when the
+// error carries an ErrorList, each entry exposing a JSON-pointer-like 'pointer', the rejected
+// 'value', a machine-readable 'reason', and a human-readable 'detail'.
 //
 // Usage:
 //
@@ -81,6 +104,10 @@ func ProblemJSON(err error, instance string, pbType ...ProblemJSONType) map[stri
 	detail := DetailFromErr(err)
 	params := ParamsFromErr(err)
 
+	if base, ok := BaseFromErr(err); ok && base.PublicMessage() != "" {
+		detail = base.PublicMessage()
+	}
+
 	t := BlankType
 	if len(pbType) > 0 {
 		t = pbType[0]
@@ -100,5 +127,22 @@ func ProblemJSON(err error, instance string, pbType ...ProblemJSONType) map[stri
 		errMap["params"] = params
 	}
 
+	if retryAfter, ok := params["retryAfter"]; ok {
+		errMap["retryAfter"] = retryAfter
+	}
+
+	if fieldErrors := ErrorListFromErr(err); len(fieldErrors) > 0 {
+		entries := make([]map[string]any, 0, len(fieldErrors))
+		for _, fe := range fieldErrors {
+			entries = append(entries, map[string]any{
+				"pointer": fe.Path.String(),
+				"value":   fe.BadValue,
+				"reason":  string(fe.Kind),
+				"detail":  fe.Detail,
+			})
+		}
+		errMap["errors"] = entries
+	}
+
 	return errMap
 }
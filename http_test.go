@@ -65,3 +65,57 @@ func TestHTTPStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestProblemJSONErrors(t *testing.T) {
+	err := errs.New(errs.SlugInvalid).WithErrorList(errs.ErrorList{
+		errs.NewRequired(errs.NewPath("name"), "must not be empty"),
+		errs.NewInvalid(errs.NewPath("age"), -1, "must be positive"),
+	})
+
+	got := errs.ProblemJSON(err, "http://example.com/err/1234")
+
+	entries, ok := got["errors"].([]map[string]any)
+	if !ok {
+		t.Fatalf("ProblemJSON()[\"errors\"] = %T, want []map[string]any", got["errors"])
+	}
+	if len(entries) != 2 {
+		t.Fatalf("len(ProblemJSON()[\"errors\"]) = %d, want 2", len(entries))
+	}
+
+	if entries[0]["pointer"] != "name" || entries[0]["reason"] != string(errs.ErrorKindRequired) {
+		t.Errorf("entries[0] = %+v, want pointer=name reason=%s", entries[0], errs.ErrorKindRequired)
+	}
+	if entries[1]["pointer"] != "age" || entries[1]["value"] != -1 {
+		t.Errorf("entries[1] = %+v, want pointer=age value=-1", entries[1])
+	}
+}
+
+func TestProblemJSONNoErrors(t *testing.T) {
+	err := errs.New(errs.SlugNotFound)
+
+	got := errs.ProblemJSON(err, "http://example.com/err/1234")
+
+	if _, ok := got["errors"]; ok {
+		t.Errorf("ProblemJSON()[\"errors\"] = %v, want absent", got["errors"])
+	}
+}
+
+func TestProblemJSONRetryAfter(t *testing.T) {
+	err := errs.New(errs.SlugTooManyRequests).WithParams(map[string]string{"retryAfter": "30"})
+
+	got := errs.ProblemJSON(err, "http://example.com/err/9012")
+
+	if got["retryAfter"] != "30" {
+		t.Errorf(`ProblemJSON()["retryAfter"] = %v, want "30"`, got["retryAfter"])
+	}
+}
+
+func TestProblemJSONNoRetryAfter(t *testing.T) {
+	err := errs.New(errs.SlugNotFound)
+
+	got := errs.ProblemJSON(err, "http://example.com/err/9012")
+
+	if _, ok := got["retryAfter"]; ok {
+		t.Errorf(`ProblemJSON()["retryAfter"] = %v, want absent`, got["retryAfter"])
+	}
+}
@@ -0,0 +1,211 @@
+// Package httperrs provides an HTTP middleware and renderer that turn an
+// error returned by a handler into an RFC 9457 "application/problem+json" (or
+// "+xml") response, built on top of errs.HTTPStatus and errs.ProblemJSON.
+package httperrs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/valensto/errs"
+)
+
+// traceIDKey is the context key under which a request's correlation/trace ID
+// is stored by WithTraceID.
+type traceIDKey struct{}
+
+// WithTraceID returns a copy of ctx carrying id as the request's correlation
+// ID, to be picked up by Render instead of generating a new one.
+func WithTraceID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, traceIDKey{}, id)
+}
+
+// TraceIDFromContext returns the correlation ID previously stored by
+// WithTraceID, if any.
+func TraceIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(traceIDKey{}).(string)
+	return id, ok
+}
+
+// newTraceID generates a new random correlation ID, used when the request's
+// context does not already carry one.
+func newTraceID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "unknown"
+	}
+
+	return hex.EncodeToString(buf)
+}
+
+// Logger logs the given error at the given severity. It is the extension
+// point Render uses to report the underlying wrapped error chain without
+// exposing it to the client.
+type Logger func(level errs.LogLevel, message string, err error)
+
+// renderOptions holds the configuration accumulated from RenderOptions.
+type renderOptions struct {
+	typePrefix string
+	logger     Logger
+}
+
+// RenderOption configures Render.
+type RenderOption func(*renderOptions)
+
+// WithTypePrefix sets a base URI prefix (e.g. "https://errors.example.com")
+// used to build the Problem Details "type" field as prefix/<slug>, so that
+// clients can dereference it to a human-readable page per RFC 9457.
+func WithTypePrefix(prefix string) RenderOption {
+	return func(o *renderOptions) {
+		o.typePrefix = strings.TrimRight(prefix, "/")
+	}
+}
+
+// WithLogger sets the Logger used to report the underlying wrapped error
+// chain at a severity derived from the error's slug/Base.
+func WithLogger(logger Logger) RenderOption {
+	return func(o *renderOptions) {
+		o.logger = logger
+	}
+}
+
+// Render writes err to w as an RFC 9457 Problem Details response. It
+// computes the status via errs.HTTPStatus, builds the body via
+// errs.ProblemJSON using r.URL as the instance, attaches a correlation ID
+// (read from r's context or freshly generated) as the "traceId" extension,
+// and logs the underlying error chain through the configured Logger while
+// keeping the client-visible payload free of it. The response is encoded as
+// "application/problem+json", or "application/problem+xml" when the request's
+// Accept header lists that media type.
+func Render(w http.ResponseWriter, r *http.Request, err error, opts ...RenderOption) {
+	cfg := &renderOptions{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	status := errs.HTTPStatus(err)
+	slug := errs.SlugFromErr(err)
+
+	pbType := errs.BlankType
+	if cfg.typePrefix != "" {
+		pbType = errs.ProblemJSONType(cfg.typePrefix + "/" + slug.Error())
+	}
+
+	traceID, ok := TraceIDFromContext(r.Context())
+	if !ok {
+		traceID = newTraceID()
+	}
+
+	body := errs.ProblemJSON(err, r.URL.String(), pbType)
+	body["traceId"] = traceID
+
+	logError(cfg.logger, err)
+
+	if wantsXML(r) {
+		writeXML(w, status, body)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// Handler adapts next, an HTTP handler that returns an error instead of
+// writing its own error response, into a standard http.Handler. Any non-nil
+// error is rendered via Render.
+func Handler(next func(http.ResponseWriter, *http.Request) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := next(w, r); err != nil {
+			Render(w, r, err)
+		}
+	})
+}
+
+// logError reports err's underlying chain through logger, falling back to
+// the standard library logger. The severity is taken from the error's Base
+// when one is bound; otherwise it is derived from the slug's classification,
+// so a routine client error (e.g. SlugNotFound) doesn't get logged at the
+// same severity as an unexpected server-side failure.
+func logError(logger Logger, err error) {
+	level := defaultLogLevel(err)
+	if base, ok := errs.BaseFromErr(err); ok {
+		level = base.LogLevel()
+	}
+
+	if logger != nil {
+		logger(level, "request failed", err)
+		return
+	}
+
+	log.Printf("[%s] request failed: %v", level, err)
+}
+
+// defaultLogLevel derives a severity for err from its slug's classification
+// when it carries no Base: client errors are routine (LogLevelInfo), server
+// errors warrant operator attention (LogLevelError).
+func defaultLogLevel(err error) errs.LogLevel {
+	if errs.IsClientError(err) {
+		return errs.LogLevelInfo
+	}
+
+	return errs.LogLevelError
+}
+
+// wantsXML reports whether the request's Accept header lists
+// "application/problem+xml" anywhere. Quality values and the order of the
+// listed media types are not considered.
+func wantsXML(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "application/problem+xml")
+}
+
+// problemXML mirrors the standard RFC 9457 members for the XML encoding, plus
+// the "traceId" added by Render. Other extensions (e.g. "params", "errors")
+// are not represented in XML, since Problem Details does not define a
+// canonical XML extension mechanism the way it does for JSON.
+type problemXML struct {
+	XMLName  xml.Name `xml:"urn:ietf:rfc:9457 problem"`
+	Type     string   `xml:"type"`
+	Title    string   `xml:"title"`
+	Status   int      `xml:"status"`
+	Detail   string   `xml:"detail,omitempty"`
+	Instance string   `xml:"instance"`
+	TraceID  string   `xml:"traceId,omitempty"`
+}
+
+// writeXML writes body as an "application/problem+xml" response, projecting
+// the standard Problem Details members and "traceId" out of the map built by
+// Render.
+func writeXML(w http.ResponseWriter, status int, body map[string]any) {
+	p := problemXML{Status: status}
+	if v, ok := body["type"]; ok {
+		if t, ok := v.(errs.ProblemJSONType); ok {
+			p.Type = string(t)
+		}
+	}
+	if v, ok := body["title"].(string); ok {
+		p.Title = v
+	} else if v, ok := body["title"]; ok {
+		p.Title = fmt.Sprint(v)
+	}
+	if v, ok := body["detail"].(string); ok {
+		p.Detail = v
+	}
+	if v, ok := body["instance"].(string); ok {
+		p.Instance = v
+	}
+	if v, ok := body["traceId"].(string); ok {
+		p.TraceID = v
+	}
+
+	w.Header().Set("Content-Type", "application/problem+xml")
+	w.WriteHeader(status)
+	_ = xml.NewEncoder(w).Encode(p)
+}
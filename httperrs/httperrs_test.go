@@ -0,0 +1,169 @@
+package httperrs_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/valensto/errs"
+	"github.com/valensto/errs/httperrs"
+)
+
+func TestRenderJSON(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	rec := httptest.NewRecorder()
+
+	httperrs.Render(rec, req, errs.New(errs.SlugNotFound, "user 42"))
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+		t.Errorf("Content-Type = %q, want %q", ct, "application/problem+json")
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if body["status"] != float64(http.StatusNotFound) {
+		t.Errorf("body[status] = %v, want %v", body["status"], http.StatusNotFound)
+	}
+	if _, ok := body["traceId"]; !ok {
+		t.Error("body[traceId] missing")
+	}
+}
+
+func TestRenderUsesTraceIDFromContext(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	req = req.WithContext(httperrs.WithTraceID(req.Context(), "trace-123"))
+	rec := httptest.NewRecorder()
+
+	httperrs.Render(rec, req, errs.New(errs.SlugInternal))
+
+	var body map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if body["traceId"] != "trace-123" {
+		t.Errorf("body[traceId] = %v, want %q", body["traceId"], "trace-123")
+	}
+}
+
+func TestRenderTypePrefix(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	rec := httptest.NewRecorder()
+
+	httperrs.Render(rec, req, errs.New(errs.SlugDuplicate), httperrs.WithTypePrefix("https://errors.example.com/"))
+
+	var body map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	want := "https://errors.example.com/" + errs.SlugDuplicate.Error()
+	if body["type"] != want {
+		t.Errorf("body[type] = %v, want %q", body["type"], want)
+	}
+}
+
+func TestRenderXMLNegotiation(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	req.Header.Set("Accept", "application/problem+xml")
+	rec := httptest.NewRecorder()
+
+	httperrs.Render(rec, req, errs.New(errs.SlugForbidden, "no access"))
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/problem+xml" {
+		t.Errorf("Content-Type = %q, want %q", ct, "application/problem+xml")
+	}
+
+	var body struct {
+		XMLName xml.Name `xml:"problem"`
+		Title   string   `xml:"title"`
+		Status  int      `xml:"status"`
+		Detail  string   `xml:"detail"`
+	}
+	if err := xml.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v", err)
+	}
+	if body.Status != http.StatusForbidden {
+		t.Errorf("body.Status = %d, want %d", body.Status, http.StatusForbidden)
+	}
+	if body.Detail != "no access" {
+		t.Errorf("body.Detail = %q, want %q", body.Detail, "no access")
+	}
+}
+
+func TestHandler(t *testing.T) {
+	handler := httperrs.Handler(func(w http.ResponseWriter, r *http.Request) error {
+		return errs.New(errs.SlugUnauthorized)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestRenderLogLevel(t *testing.T) {
+	base := errs.NewBase("test.render-log-level-base", errs.SlugInternal, errs.WithLogLevel(errs.LogLevelWarn))
+
+	tests := []struct {
+		name string
+		err  error
+		want errs.LogLevel
+	}{
+		{name: "client error, no base", err: errs.New(errs.SlugNotFound), want: errs.LogLevelInfo},
+		{name: "server error, no base", err: errs.New(errs.SlugInternal), want: errs.LogLevelError},
+		{name: "base overrides classification", err: base.Errorf("boom"), want: errs.LogLevelWarn},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+
+			var gotLevel errs.LogLevel
+			logged := false
+			logger := func(level errs.LogLevel, message string, err error) {
+				gotLevel = level
+				logged = true
+			}
+
+			httperrs.Render(rec, req, tt.err, httperrs.WithLogger(logger))
+
+			if !logged {
+				t.Fatal("logger was not called")
+			}
+			if gotLevel != tt.want {
+				t.Errorf("log level = %v, want %v", gotLevel, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandlerNoError(t *testing.T) {
+	handler := httperrs.Handler(func(w http.ResponseWriter, r *http.Request) error {
+		w.WriteHeader(http.StatusOK)
+		return nil
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
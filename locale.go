@@ -0,0 +1,167 @@
+package errs
+
+import (
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// Translator holds the process-wide catalog of locale-specific messages used
+// by Err.Localize. Slugs are translated through RegisterLocale, while
+// individual Base definitions can be overridden through RegisterBaseLocale
+// for messages more specific than a generic slug title.
+type Translator struct {
+	mu          sync.RWMutex
+	tags        []language.Tag
+	tagSet      map[language.Tag]struct{}
+	slugCatalog map[language.Tag]map[Slug]string
+	baseCatalog map[string]map[language.Tag]string
+}
+
+// defaultTranslator is the process-wide Translator used by RegisterLocale,
+// RegisterBaseLocale, and Err.Localize.
+var defaultTranslator = &Translator{
+	tagSet:      map[language.Tag]struct{}{},
+	slugCatalog: map[language.Tag]map[Slug]string{},
+	baseCatalog: map[string]map[language.Tag]string{},
+}
+
+// RegisterLocale registers a catalog of slug titles for the given BCP 47
+// locale tag (e.g. "fr"), used by Err.Localize and ProblemJSONLocalized to
+// translate the "title" field of a problem response.
+func RegisterLocale(tag string, catalog map[Slug]string) {
+	defaultTranslator.registerLocale(tag, catalog)
+}
+
+// RegisterBaseLocale registers a locale-specific override of a Base's
+// PublicMessage, addressed by its MessageID, for the given BCP 47 locale tag.
+// The message may contain "{param}"-style placeholders interpolated from the
+// Err's Params.
+func RegisterBaseLocale(messageID, tag, message string) {
+	defaultTranslator.registerBaseLocale(messageID, tag, message)
+}
+
+func (t *Translator) registerLocale(tag string, catalog map[Slug]string) {
+	parsed := language.Make(tag)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.addTagLocked(parsed)
+
+	merged := t.slugCatalog[parsed]
+	if merged == nil {
+		merged = map[Slug]string{}
+	}
+	for slug, message := range catalog {
+		merged[slug] = message
+	}
+	t.slugCatalog[parsed] = merged
+}
+
+func (t *Translator) registerBaseLocale(messageID, tag, message string) {
+	parsed := language.Make(tag)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.addTagLocked(parsed)
+
+	if t.baseCatalog[messageID] == nil {
+		t.baseCatalog[messageID] = map[language.Tag]string{}
+	}
+	t.baseCatalog[messageID][parsed] = message
+}
+
+// addTagLocked records tag in the matcher pool if it hasn't been seen yet,
+// from either a slug or a Base override registration. t.mu must already be
+// held for writing.
+func (t *Translator) addTagLocked(tag language.Tag) {
+	if _, ok := t.tagSet[tag]; ok {
+		return
+	}
+
+	t.tagSet[tag] = struct{}{}
+	t.tags = append(t.tags, tag)
+}
+
+func (t *Translator) match(want language.Tag) language.Tag {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if len(t.tags) == 0 {
+		return language.Und
+	}
+
+	matcher := language.NewMatcher(t.tags)
+	_, index, confidence := matcher.Match(want)
+	if confidence == language.No {
+		return language.Und
+	}
+
+	return t.tags[index]
+}
+
+// Localize returns the title and detail for an Err translated into the given
+// locale tag, falling back to the slug's own Error() string and the Err's
+// details (or its Base's PublicMessage, when set) when no translation is
+// registered. Placeholders of the form
+// "{param}" in the resolved detail are interpolated from the Err's Params.
+func (e Err) Localize(tag language.Tag) (title, detail string) {
+	title = e.slug.Error()
+	detail = e.details
+
+	if e.base != nil && e.base.publicMessage != "" {
+		detail = e.base.publicMessage
+	}
+
+	best := defaultTranslator.match(tag)
+
+	defaultTranslator.mu.RLock()
+	if catalog, ok := defaultTranslator.slugCatalog[best]; ok {
+		if msg, ok := catalog[e.slug]; ok {
+			title = msg
+		}
+	}
+	if e.base != nil {
+		if overrides, ok := defaultTranslator.baseCatalog[e.base.messageID]; ok {
+			if msg, ok := overrides[best]; ok {
+				detail = msg
+			}
+		}
+	}
+	defaultTranslator.mu.RUnlock()
+
+	return title, interpolate(detail, e.params)
+}
+
+// interpolate replaces "{key}" placeholders in msg with the corresponding
+// value from params. It is a deliberately small subset of ICU MessageFormat,
+// covering the simple named-placeholder substitution Params already supports.
+func interpolate(msg string, params Params) string {
+	for key, value := range params {
+		msg = strings.ReplaceAll(msg, "{"+key+"}", value)
+	}
+
+	return msg
+}
+
+// ProblemJSONLocalized builds the same Problem Details map as ProblemJSON,
+// but with "title" and "detail" translated into the given locale tag via the
+// registered catalog, negotiated through golang.org/x/text/language.
+func ProblemJSONLocalized(err error, instance string, tag language.Tag, pbType ...ProblemJSONType) map[string]any {
+	body := ProblemJSON(err, instance, pbType...)
+
+	e := getErr(err)
+	title, detail := e.Localize(tag)
+
+	body["title"] = title
+	if detail != "" {
+		body["detail"] = detail
+	} else {
+		delete(body, "detail")
+	}
+
+	return body
+}
@@ -0,0 +1,81 @@
+package errs_test
+
+import (
+	"testing"
+
+	"github.com/valensto/errs"
+	"golang.org/x/text/language"
+)
+
+func TestLocalizeFallback(t *testing.T) {
+	err := errs.New(errs.SlugNotFound, "user 42")
+
+	title, detail := err.Localize(language.Make("fr"))
+
+	if title != errs.SlugNotFound.Error() {
+		t.Errorf("Localize() title = %q, want %q", title, errs.SlugNotFound.Error())
+	}
+	if detail != "user 42" {
+		t.Errorf("Localize() detail = %q, want %q", detail, "user 42")
+	}
+}
+
+func TestLocalizeSlugOverride(t *testing.T) {
+	errs.RegisterLocale("fr", map[errs.Slug]string{
+		errs.SlugNotFound: "introuvable",
+	})
+
+	err := errs.New(errs.SlugNotFound)
+
+	title, _ := err.Localize(language.Make("fr"))
+
+	if title != "introuvable" {
+		t.Errorf("Localize() title = %q, want %q", title, "introuvable")
+	}
+}
+
+func TestLocalizeBaseOverrideWithInterpolation(t *testing.T) {
+	base := errs.NewBase("test.localize-base-override", errs.SlugInvalid,
+		errs.WithPublicMessage("default message"),
+	)
+	errs.RegisterBaseLocale(base.MessageID(), "fr", "valeur invalide pour {field}")
+
+	err := base.Errorf("invalid").WithParams(map[string]string{"field": "email"})
+
+	_, detail := err.Localize(language.Make("fr"))
+
+	if want := "valeur invalide pour email"; detail != want {
+		t.Errorf("Localize() detail = %q, want %q", detail, want)
+	}
+}
+
+func TestLocalizeBaseNoOverrideUsesPublicMessage(t *testing.T) {
+	base := errs.NewBase("test.localize-base-no-override", errs.SlugInvalid,
+		errs.WithPublicMessage("default message"),
+	)
+
+	err := base.Errorf("invalid")
+
+	_, detail := err.Localize(language.Make("fr"))
+
+	if detail != "default message" {
+		t.Errorf("Localize() detail = %q, want %q", detail, "default message")
+	}
+}
+
+func TestProblemJSONLocalized(t *testing.T) {
+	errs.RegisterLocale("fr", map[errs.Slug]string{
+		errs.SlugDuplicate: "en double",
+	})
+
+	err := errs.New(errs.SlugDuplicate, "email already used")
+
+	got := errs.ProblemJSONLocalized(err, "http://example.com/err/5678", language.Make("fr"))
+
+	if got["title"] != "en double" {
+		t.Errorf(`ProblemJSONLocalized()["title"] = %v, want "en double"`, got["title"])
+	}
+	if got["detail"] != "email already used" {
+		t.Errorf(`ProblemJSONLocalized()["detail"] = %v, want "email already used"`, got["detail"])
+	}
+}
@@ -35,6 +35,29 @@ type (
 
 	// Unknown represents an unspecified error, used as a fallback.
 	Unknown slug
+
+	// Unavailable represents a 503 Service Unavailable error, indicating the
+	// dependency or service is temporarily unable to handle the request.
+	Unavailable slug
+
+	// Timeout represents a request that took too long, typically surfaced as
+	// a 504 Gateway Timeout.
+	Timeout slug
+
+	// TooManyRequests represents a 429 Too Many Requests error, indicating
+	// the caller has been rate limited.
+	TooManyRequests slug
+
+	// PreconditionFailed represents a 412 Precondition Failed error, indicating
+	// a conditional request (e.g. based on an ETag) did not match server state.
+	PreconditionFailed slug
+
+	// Cancelled represents a request that was cancelled by the caller before
+	// completion, conventionally surfaced as HTTP status 499.
+	Cancelled slug
+
+	// DataLoss represents an unrecoverable loss or corruption of data.
+	DataLoss slug
 )
 
 // Constants for each slug type, providing clear and concise identifiers for common error conditions.
@@ -48,6 +71,13 @@ const (
 	SlugDuplicate      Duplicate      = "already-exists"
 	SlugNotImplemented NotImplemented = "not-implemented"
 	SlugInternal       Internal       = "internal-error"
+
+	SlugUnavailable        Unavailable        = "unavailable"
+	SlugTimeout            Timeout            = "timeout"
+	SlugTooManyRequests    TooManyRequests    = "too-many-requests"
+	SlugPreconditionFailed PreconditionFailed = "precondition-failed"
+	SlugCancelled          Cancelled          = "cancelled"
+	SlugDataLoss           DataLoss           = "data-loss"
 )
 
 // Error returns the string representation of the NotFound error slug,
@@ -84,6 +114,30 @@ func (s Internal) Error() string {
 	return string(s)
 }
 
+func (s Unavailable) Error() string {
+	return string(s)
+}
+
+func (s Timeout) Error() string {
+	return string(s)
+}
+
+func (s TooManyRequests) Error() string {
+	return string(s)
+}
+
+func (s PreconditionFailed) Error() string {
+	return string(s)
+}
+
+func (s Cancelled) Error() string {
+	return string(s)
+}
+
+func (s DataLoss) Error() string {
+	return string(s)
+}
+
 // SlugFromErr extracts the Slug from a given error, if the error is of type Err and contains
 // a slug. This function is useful for determining the type of an error when handling it,
 // especially in situations where the specific error type influences the application's response.